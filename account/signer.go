@@ -0,0 +1,49 @@
+package account
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	btcecdsa "github.com/btcsuite/btcd/btcec/v2/ecdsa"
+
+	"github.com/coming-chat/go-sui/types"
+)
+
+// Ed25519Signer signs transaction bytes with an Ed25519 private key.
+// It implements types.Signer.
+type Ed25519Signer struct {
+	PrivateKey ed25519.PrivateKey
+}
+
+func NewEd25519Signer(privateKey ed25519.PrivateKey) *Ed25519Signer {
+	return &Ed25519Signer{PrivateKey: privateKey}
+}
+
+func (s *Ed25519Signer) Sign(msg []byte) (signature, pubKey []byte, scheme types.SignatureScheme) {
+	publicKey := s.PrivateKey.Public().(ed25519.PublicKey)
+	return ed25519.Sign(s.PrivateKey, msg), publicKey, types.SignatureSchemeEd25519
+}
+
+// Secp256k1Signer signs transaction bytes with a secp256k1 private key, the
+// scheme used by imported Ethereum-style keys and most hardware wallets.
+// It implements types.Signer.
+type Secp256k1Signer struct {
+	PrivateKey *btcec.PrivateKey
+}
+
+func NewSecp256k1Signer(privateKey *btcec.PrivateKey) *Secp256k1Signer {
+	return &Secp256k1Signer{PrivateKey: privateKey}
+}
+
+func (s *Secp256k1Signer) Sign(msg []byte) (signature, pubKey []byte, scheme types.SignatureScheme) {
+	digest := sha256.Sum256(msg)
+	// SignCompact prefixes the 64-byte r||s signature with a 1-byte recovery
+	// header; Sui only wants the raw r||s bytes.
+	compact, err := btcecdsa.SignCompact(s.PrivateKey, digest[:], false)
+	if err != nil {
+		panic(fmt.Sprintf("account: secp256k1 signing failed: %v", err))
+	}
+	return compact[1:], s.PrivateKey.PubKey().SerializeCompressed(), types.SignatureSchemeSecp256k1
+}
@@ -113,6 +113,16 @@ func (c *Client) ExecuteTransaction(ctx context.Context, txn types.SignedTransac
 	return &resp, err
 }
 
+// ExecuteSignedTransaction signs txBytes with signer and submits the result,
+// deriving SigScheme, Signature and PublicKey from the signer instead of
+// requiring the caller to assemble a types.SignedTransaction by hand. This is
+// the scheme-agnostic counterpart to ExecuteTransaction: pass an
+// account.Ed25519Signer or account.Secp256k1Signer and it works the same way.
+func (c *Client) ExecuteSignedTransaction(ctx context.Context, txBytes *types.TransactionBytes, signer types.Signer, requestType types.ExecuteTransactionRequestType) (*types.ExecuteTransactionResponse, error) {
+	signedTxn := txBytes.SignWithSigner(signer)
+	return c.ExecuteTransaction(ctx, *signedTxn, requestType)
+}
+
 func (c *Client) GetObject(ctx context.Context, objID types.ObjectId) (*types.ObjectRead, error) {
 	resp := types.ObjectRead{}
 	err := c.CallContext(ctx, &resp, "sui_getObject", objID)
@@ -203,8 +213,10 @@ func (c *Client) MergeCoins(ctx context.Context, signer types.Address, primaryCo
 }
 
 // Create an unsigned transaction to execute a Move call on the network, by calling the specified function in the module of a given package.
-// TODO: not support param `typeArguments` yet.
-// So now only methods with `typeArguments` are supported
+// arguments are sent as-is, so callers are responsible for rendering each
+// one to the JSON shape sui_moveCall expects. Prefer MoveCallTyped, which
+// builds arguments from []move.MoveValue and validates them against the
+// function's normalized ABI first.
 func (c *Client) MoveCall(ctx context.Context, signer types.Address, packageId types.ObjectId, module, function string, typeArgs []string, arguments []any, gas *types.ObjectId, gasBudget uint64) (*types.TransactionBytes, error) {
 	resp := types.TransactionBytes{}
 	err := c.CallContext(ctx, &resp, "sui_moveCall", signer, packageId, module, function, typeArgs, arguments, gas, gasBudget)
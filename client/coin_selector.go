@@ -0,0 +1,81 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/coming-chat/go-sui/types"
+)
+
+// CoinSelectionStrategy picks which coins from a candidate set are spent to
+// cover a target amount.
+type CoinSelectionStrategy int
+
+const (
+	// LargestFirst spends the fewest, largest coins first, minimizing the
+	// number of transaction inputs.
+	LargestFirst CoinSelectionStrategy = iota
+	// SmallestFirst spends the smallest coins first, useful for sweeping
+	// dust coins out of a wallet.
+	SmallestFirst
+	// ExactMatch selects a single coin whose balance exactly equals the
+	// target amount, failing if none exists.
+	ExactMatch
+	// Merge selects every available coin, for merging a wallet's coins into
+	// one before a transfer.
+	Merge
+)
+
+// CoinSelector picks input coins from a set of owned coins to cover a target
+// amount, so callers of TransferSui, PayAllSui, SplitCoin and MoveCall don't
+// have to hand-select coin objects themselves.
+type CoinSelector struct {
+	Strategy CoinSelectionStrategy
+}
+
+func NewCoinSelector(strategy CoinSelectionStrategy) *CoinSelector {
+	return &CoinSelector{Strategy: strategy}
+}
+
+// Select returns a subset of coins whose combined balance covers amount:
+// exactly amount for ExactMatch, or every coin in coins for Merge.
+func (s *CoinSelector) Select(coins types.Coins, amount uint64) (types.Coins, error) {
+	switch s.Strategy {
+	case LargestFirst:
+		return selectFirstFit(coins, amount, func(a, b types.Coin) bool { return a.Balance > b.Balance })
+	case SmallestFirst:
+		return selectFirstFit(coins, amount, func(a, b types.Coin) bool { return a.Balance < b.Balance })
+	case ExactMatch:
+		for _, c := range coins {
+			if c.Balance == amount {
+				return types.Coins{c}, nil
+			}
+		}
+		return nil, fmt.Errorf("client: no coin with an exact balance of %d", amount)
+	case Merge:
+		if len(coins) == 0 {
+			return nil, errors.New("client: no coins available to merge")
+		}
+		return coins, nil
+	default:
+		return nil, fmt.Errorf("client: unknown coin selection strategy %d", s.Strategy)
+	}
+}
+
+func selectFirstFit(coins types.Coins, amount uint64, less func(a, b types.Coin) bool) (types.Coins, error) {
+	sorted := make(types.Coins, len(coins))
+	copy(sorted, coins)
+	sort.Slice(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+
+	var selected types.Coins
+	var total uint64
+	for _, c := range sorted {
+		selected = append(selected, c)
+		total += c.Balance
+		if total >= amount {
+			return selected, nil
+		}
+	}
+	return nil, fmt.Errorf("client: insufficient balance, have %d, need %d", total, amount)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/stretchr/testify/require"
+)
+
+func coin(balance uint64) types.Coin {
+	return types.Coin{Balance: balance, Reference: &types.ObjectRef{}}
+}
+
+func TestCoinSelectorLargestFirst(t *testing.T) {
+	coins := types.Coins{coin(10), coin(100), coin(5)}
+	selected, err := NewCoinSelector(LargestFirst).Select(coins, 50)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, uint64(100), selected[0].Balance)
+}
+
+func TestCoinSelectorSmallestFirst(t *testing.T) {
+	coins := types.Coins{coin(10), coin(100), coin(5)}
+	// No single coin covers 12, so SmallestFirst must combine more than one.
+	selected, err := NewCoinSelector(SmallestFirst).Select(coins, 12)
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	require.Equal(t, uint64(5), selected[0].Balance)
+	require.Equal(t, uint64(10), selected[1].Balance)
+}
+
+func TestCoinSelectorExactMatch(t *testing.T) {
+	coins := types.Coins{coin(10), coin(100)}
+	selected, err := NewCoinSelector(ExactMatch).Select(coins, 10)
+	require.NoError(t, err)
+	require.Len(t, selected, 1)
+	require.Equal(t, uint64(10), selected[0].Balance)
+
+	_, err = NewCoinSelector(ExactMatch).Select(coins, 11)
+	require.Error(t, err)
+}
+
+func TestCoinSelectorMerge(t *testing.T) {
+	coins := types.Coins{coin(10), coin(100), coin(5)}
+	selected, err := NewCoinSelector(Merge).Select(coins, 1)
+	require.NoError(t, err)
+	require.Len(t, selected, 3)
+
+	_, err = NewCoinSelector(Merge).Select(nil, 1)
+	require.Error(t, err)
+}
+
+func TestCoinSelectorInsufficientBalance(t *testing.T) {
+	coins := types.Coins{coin(10), coin(5)}
+	_, err := NewCoinSelector(LargestFirst).Select(coins, 1000)
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coming-chat/go-sui/faucet"
+	"github.com/coming-chat/go-sui/types"
+)
+
+// RequestFaucet requests funds for address from network's faucet and
+// returns the ObjectRefs of the coins it sent, so integration tests and
+// example programs can bootstrap a funded account without leaving the
+// Client. It retries with exponential backoff on failure or rate limiting;
+// see faucet.RequestSuiWithConfig to customize that behavior.
+//
+// The faucet response itself doesn't carry each coin's version (see
+// faucet.RequestSui), so this resolves every returned coin's current
+// Reference via GetObject before returning - without it, the refs couldn't
+// reliably be used as an input to a follow-up transaction.
+func (c *Client) RequestFaucet(ctx context.Context, network faucet.Network, address types.Address) ([]types.ObjectRef, error) {
+	refs, err := faucet.RequestSui(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]types.ObjectRef, len(refs))
+	for i, ref := range refs {
+		obj, err := c.GetObject(ctx, ref.ObjectId)
+		if err != nil {
+			return nil, fmt.Errorf("client: resolving faucet coin %s: %w", ref.ObjectId.ShortString(), err)
+		}
+		if obj.Details == nil || obj.Details.Reference == nil {
+			return nil, fmt.Errorf("client: faucet coin %s: %s", ref.ObjectId.ShortString(), obj.Status)
+		}
+		resolved[i] = *obj.Details.Reference
+	}
+	return resolved, nil
+}
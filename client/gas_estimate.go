@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coming-chat/go-sui/types"
+)
+
+// DefaultGasSafetyMultiplier pads a dry run's measured gas usage to leave
+// headroom for estimation error, the same margin most wallets add on top of
+// an eth_estimateGas-style quote.
+const DefaultGasSafetyMultiplier = 1.5
+
+// placeholderGasBudget is used to build a transaction only so it can be
+// dry-run for an estimate; it's replaced by the estimated budget before the
+// transaction is actually signed and submitted.
+const placeholderGasBudget = 10000
+
+// EstimateGasBudget dry-runs tx and returns a gas budget safetyMultiplier
+// times the gas it actually used. A safetyMultiplier of 0 falls back to
+// DefaultGasSafetyMultiplier. This follows the same pattern as
+// ContractTransactor.EstimateGas in the Ethereum bindings: execute once
+// against current state, then pad the measured cost rather than asking the
+// caller to guess a budget up front.
+func (c *Client) EstimateGasBudget(ctx context.Context, tx *types.TransactionBytes, safetyMultiplier float64) (uint64, error) {
+	if safetyMultiplier <= 0 {
+		safetyMultiplier = DefaultGasSafetyMultiplier
+	}
+	effects, err := c.DryRunTransaction(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	used := gasUsed(effects.GasUsed)
+	return uint64(float64(used) * safetyMultiplier), nil
+}
+
+// gasUsed computes the net gas a dry run actually spent. StorageRebate
+// commonly exceeds ComputationCost+StorageCost for transactions that free
+// up storage (deleting/consuming objects), which would underflow this as a
+// plain uint64 subtraction into a huge number, so it's clamped at zero
+// instead.
+func gasUsed(gas types.GasCostSummary) uint64 {
+	cost := gas.ComputationCost + gas.StorageCost
+	if cost <= gas.StorageRebate {
+		return 0
+	}
+	return cost - gas.StorageRebate
+}
+
+// TransferSuiAuto selects a gas/input coin via selector, builds a TransferSui
+// transaction, dry-runs it to estimate the gas budget, then signs and
+// submits it with signer. It collapses the coin-selection + dry-run +
+// budget + build boilerplate that every hand-written transfer otherwise
+// repeats. A nil selector defaults to LargestFirst.
+func (c *Client) TransferSuiAuto(ctx context.Context, signer types.Signer, sender, recipient types.Address, amount uint64, selector *CoinSelector) (*types.ExecuteTransactionResponse, error) {
+	if selector == nil {
+		selector = NewCoinSelector(LargestFirst)
+	}
+
+	coins, err := c.GetSuiCoinsOwnedByAddress(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := selector.Select(coins, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCoin := selected[0].Reference.ObjectId
+	if len(selected) > 1 {
+		// TransferSui can only spend a single coin object, but a selector
+		// can return more than one when no single coin covers amount (or,
+		// for Merge, by design). Merge the rest into the first coin first;
+		// any other strategy returning multiple coins means the balance is
+		// split in a way this call can't satisfy on its own.
+		if selector.Strategy != Merge {
+			return nil, fmt.Errorf("client: balance for %d is split across %d coins, which TransferSui can't spend at once; use NewCoinSelector(Merge) to combine them first", amount, len(selected))
+		}
+		gasCoin, err = c.mergeCoins(ctx, signer, sender, selected)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := c.TransferSui(ctx, sender, recipient, gasCoin, amount, placeholderGasBudget)
+	if err != nil {
+		return nil, err
+	}
+	budget, err := c.EstimateGasBudget(ctx, tx, 0)
+	if err != nil {
+		return nil, err
+	}
+	tx, err = c.TransferSui(ctx, sender, recipient, gasCoin, amount, budget)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ExecuteSignedTransaction(ctx, tx, signer, types.TxnRequestTypeWaitForLocalExecution)
+}
+
+// mergeCoins folds every coin in coins[1:] into coins[0] with a sequence of
+// signed, submitted MergeCoins transactions, returning coins[0]'s ObjectId
+// once it holds their combined balance.
+func (c *Client) mergeCoins(ctx context.Context, signer types.Signer, sender types.Address, coins types.Coins) (types.ObjectId, error) {
+	primary := coins[0].Reference.ObjectId
+	for _, coin := range coins[1:] {
+		tx, err := c.MergeCoins(ctx, sender, primary, coin.Reference.ObjectId, nil, placeholderGasBudget)
+		if err != nil {
+			return types.ObjectId{}, err
+		}
+		budget, err := c.EstimateGasBudget(ctx, tx, 0)
+		if err != nil {
+			return types.ObjectId{}, err
+		}
+		tx, err = c.MergeCoins(ctx, sender, primary, coin.Reference.ObjectId, nil, budget)
+		if err != nil {
+			return types.ObjectId{}, err
+		}
+		if _, err := c.ExecuteSignedTransaction(ctx, tx, signer, types.TxnRequestTypeWaitForLocalExecution); err != nil {
+			return types.ObjectId{}, fmt.Errorf("client: merging coin %s: %w", coin.Reference.ObjectId.ShortString(), err)
+		}
+	}
+	return primary, nil
+}
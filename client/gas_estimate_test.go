@@ -0,0 +1,35 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasUsed(t *testing.T) {
+	require.Equal(t, uint64(150), gasUsed(types.GasCostSummary{
+		ComputationCost: 100,
+		StorageCost:     100,
+		StorageRebate:   50,
+	}))
+}
+
+// TestGasUsedRebateExceedsCost checks that a rebate larger than
+// ComputationCost+StorageCost (typical for a transaction that frees up
+// storage) clamps at zero instead of wrapping around as a huge uint64.
+func TestGasUsedRebateExceedsCost(t *testing.T) {
+	require.Equal(t, uint64(0), gasUsed(types.GasCostSummary{
+		ComputationCost: 10,
+		StorageCost:     10,
+		StorageRebate:   1000,
+	}))
+}
+
+func TestGasUsedExactMatch(t *testing.T) {
+	require.Equal(t, uint64(0), gasUsed(types.GasCostSummary{
+		ComputationCost: 10,
+		StorageCost:     10,
+		StorageRebate:   20,
+	}))
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/coming-chat/go-sui/types/move"
+)
+
+// GetNormalizedMoveFunction fetches a Move entry function's normalized ABI,
+// which MoveCallTyped uses to validate arguments before a call is sent.
+func (c *Client) GetNormalizedMoveFunction(ctx context.Context, packageId types.ObjectId, module, function string) (*types.SuiMoveNormalizedFunction, error) {
+	resp := types.SuiMoveNormalizedFunction{}
+	err := c.CallContext(ctx, &resp, "sui_getNormalizedMoveFunction", packageId, module, function)
+	return &resp, err
+}
+
+// MoveCallTyped is the typed-argument counterpart to MoveCall: it fetches
+// the function's normalized ABI, validates args against the declared
+// parameter types, then renders each argument's JSON form and issues the
+// call. Use MoveCall directly (with raw []any arguments) to skip the ABI
+// fetch and validation.
+func (c *Client) MoveCallTyped(ctx context.Context, signer types.Address, packageId types.ObjectId, module, function string, typeArgs []string, args []move.MoveValue, gas *types.ObjectId, gasBudget uint64) (*types.TransactionBytes, error) {
+	abi, err := c.GetNormalizedMoveFunction(ctx, packageId, module, function)
+	if err != nil {
+		return nil, err
+	}
+	if err := move.ValidateArgs(abi, args); err != nil {
+		return nil, err
+	}
+
+	arguments := make([]interface{}, len(args))
+	for i, a := range args {
+		arguments[i], err = a.JSON()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.MoveCall(ctx, signer, packageId, module, function, typeArgs, arguments, gas, gasBudget)
+}
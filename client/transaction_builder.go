@@ -0,0 +1,153 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/coming-chat/go-sui/types/move"
+)
+
+// TransactionBuilder composes a transaction's SenderSignedData locally, so
+// its exact TxBytes are known and can be verified before ever trusting a
+// fullnode, mirroring how go-ethereum's typed transactions produce
+// canonical bytes via MarshalBinary. The RPC-backed constructors on Client
+// (MoveCall, SplitCoin, TransferSui, ...) remain available as an optional
+// convenience that still round-trips through sui_moveCall and friends; this
+// builder lets callers skip that round trip entirely.
+type TransactionBuilder struct {
+	sender       types.Address
+	transactions []types.SingleTransactionKind
+	gas          *types.ObjectRef
+	gasBudget    uint64
+	// err sticks once set so chained calls become no-ops and Build
+	// surfaces the first failure, letting AddMoveCall stay chainable even
+	// though converting its arguments can fail.
+	err error
+}
+
+// NewTransactionBuilder starts a TransactionBuilder for transactions signed
+// by sender.
+func NewTransactionBuilder(sender types.Address) *TransactionBuilder {
+	return &TransactionBuilder{sender: sender}
+}
+
+// SetGasPayment sets the gas object the transaction will consume.
+func (b *TransactionBuilder) SetGasPayment(gas types.ObjectRef) *TransactionBuilder {
+	b.gas = &gas
+	return b
+}
+
+// SetGasBudget sets the maximum gas the transaction is allowed to spend.
+func (b *TransactionBuilder) SetGasBudget(budget uint64) *TransactionBuilder {
+	b.gasBudget = budget
+	return b
+}
+
+// AddMoveCall appends a Move call to the transaction. typeArgs are Move
+// type strings (e.g. "u64", "0x2::sui::SUI"); args are typed values from
+// types/move, which also drives the real CallArg/TypeTag BCS encoding Build
+// needs to produce fullnode-matching bytes. If any type argument or value
+// can't be converted, the error is stored and returned by Build rather than
+// breaking the chain here.
+func (b *TransactionBuilder) AddMoveCall(pkg types.ObjectRef, module, function string, typeArgs []string, args []move.MoveValue) *TransactionBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	bcsTypeArgs := make([]types.TypeTag, len(typeArgs))
+	jsonTypeArgs := make([]interface{}, len(typeArgs))
+	for i, ta := range typeArgs {
+		tag, err := types.ParseTypeTag(ta)
+		if err != nil {
+			b.err = fmt.Errorf("client: AddMoveCall: %w", err)
+			return b
+		}
+		bcsTypeArgs[i] = tag
+		jsonTypeArgs[i] = ta
+	}
+
+	bcsArgs := make([]types.BCSArg, len(args))
+	jsonArgs := make([]interface{}, len(args))
+	for i, a := range args {
+		bcsArgs[i] = a
+		j, err := a.JSON()
+		if err != nil {
+			b.err = fmt.Errorf("client: AddMoveCall: argument %d: %w", i, err)
+			return b
+		}
+		jsonArgs[i] = j
+	}
+
+	b.transactions = append(b.transactions, types.SingleTransactionKind{
+		Call: &types.MoveCall{
+			Package:     pkg,
+			Module:      module,
+			Function:    function,
+			TypeArgs:    jsonTypeArgs,
+			Args:        jsonArgs,
+			BCSTypeArgs: bcsTypeArgs,
+			BCSArgs:     bcsArgs,
+		},
+	})
+	return b
+}
+
+// AddTransferSui appends a transfer of amount SUI to recipient, taken from
+// the gas object set via SetGasPayment.
+func (b *TransactionBuilder) AddTransferSui(recipient types.Address, amount uint64) *TransactionBuilder {
+	b.transactions = append(b.transactions, types.SingleTransactionKind{
+		TransferSui: &types.TransferSui{Recipient: recipient, Amount: amount},
+	})
+	return b
+}
+
+// AddTransferObject appends a transfer of object to recipient.
+func (b *TransactionBuilder) AddTransferObject(recipient types.Address, object types.ObjectRef) *TransactionBuilder {
+	b.transactions = append(b.transactions, types.SingleTransactionKind{
+		TransferObject: &types.TransferObject{Recipient: recipient, ObjectRef: object},
+	})
+	return b
+}
+
+// AddSplitCoin appends a split of coin into len(splitAmounts) new coins with
+// the given amounts, with any remainder staying in coin.
+func (b *TransactionBuilder) AddSplitCoin(coin types.ObjectId, splitAmounts []uint64) *TransactionBuilder {
+	b.transactions = append(b.transactions, types.SingleTransactionKind{
+		SplitCoin: &types.SplitCoin{Coin: coin, SplitAmounts: splitAmounts},
+	})
+	return b
+}
+
+// Build validates the accumulated transactions and returns the locally
+// computed TransactionBytes, BCS-encoding the result exactly as a fullnode
+// would for sui_moveCall et al. so the RPC path becomes optional rather than
+// required.
+func (b *TransactionBuilder) Build() (*types.TransactionBytes, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.transactions) == 0 {
+		return nil, errors.New("client: transaction builder has no transactions added")
+	}
+	if b.gas == nil {
+		return nil, errors.New("client: transaction builder has no gas payment set, call SetGasPayment")
+	}
+
+	data := types.SenderSignedData{
+		Transactions: b.transactions,
+		Sender:       &b.sender,
+		GasPayment:   b.gas,
+		GasBudget:    b.gasBudget,
+	}
+	txBytes, err := data.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TransactionBytes{
+		Gas:          *b.gas,
+		InputObjects: nil,
+		TxBytes:      types.Bytes(txBytes).GetBase64Data(),
+	}, nil
+}
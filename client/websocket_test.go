@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/coming-chat/go-sui/types"
+)
+
+// TestSubscribeEventsUsesSuiMethodNames checks that SubscribeEvents calls
+// the Sui method names "sui_subscribeEvent"/"sui_unsubscribeEvent"
+// directly, not "sui_subscribe"/"sui_unsubscribe" (the generic dispatcher
+// convention rpc.Client.Subscribe hardcodes for Ethereum-style namespaces).
+func TestSubscribeEventsUsesSuiMethodNames(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	gotSubscribeMethod := make(chan string, 1)
+	gotUnsubscribeMethod := make(chan string, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		var req subscribeRequest
+		require.NoError(t, conn.ReadJSON(&req))
+		gotSubscribeMethod <- req.Method
+		require.NoError(t, conn.WriteJSON(subscribeResponse{ID: req.ID, Result: json.RawMessage(`"1"`)}))
+
+		note := subscriptionNotification{Method: subscribeEventMethod}
+		note.Params.Subscription = "1"
+		note.Params.Result, _ = json.Marshal(types.SuiEventEnvelope{TxDigest: "abc123"})
+		require.NoError(t, conn.WriteJSON(note))
+
+		var unsub subscribeRequest
+		require.NoError(t, conn.ReadJSON(&unsub))
+		gotUnsubscribeMethod <- unsub.Method
+	}))
+	defer srv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+	c, err := DialWebsocket(context.Background(), wsURL)
+	require.NoError(t, err)
+
+	sub, err := c.SubscribeEvents(context.Background(), types.NewModuleEventFilter("coin"))
+	require.NoError(t, err)
+
+	select {
+	case m := <-gotSubscribeMethod:
+		require.Equal(t, "sui_subscribeEvent", m)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for subscribe request")
+	}
+
+	select {
+	case event := <-sub.Events():
+		require.Equal(t, "abc123", event.TxDigest)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for event notification")
+	}
+
+	sub.Unsubscribe()
+
+	select {
+	case m := <-gotUnsubscribeMethod:
+		require.Equal(t, "sui_unsubscribeEvent", m)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for unsubscribe request")
+	}
+}
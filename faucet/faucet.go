@@ -0,0 +1,192 @@
+// Package faucet requests devnet/testnet SUI from the standard Sui faucet
+// HTTP endpoint, so integration tests and example programs can bootstrap a
+// funded account in a single call instead of asking a human to fund one by
+// hand.
+package faucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/coming-chat/go-sui/types"
+)
+
+// Network identifies which faucet endpoint to request funds from.
+type Network string
+
+const (
+	Devnet  Network = "devnet"
+	Testnet Network = "testnet"
+)
+
+var endpoints = map[Network]string{
+	Devnet:  "https://faucet.devnet.sui.io/gas",
+	Testnet: "https://faucet.testnet.sui.io/gas",
+}
+
+// Config controls retry behavior against a shared, sometimes-rate-limited
+// faucet.
+type Config struct {
+	MaxAttempts  int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// DefaultConfig makes up to 5 attempts with exponential backoff starting at
+// 1s and capped at 30s before jitter, which is enough to ride out the
+// faucet's usual rate limiting without making CI runs hang.
+var DefaultConfig = Config{
+	MaxAttempts:  5,
+	InitialDelay: 1 * time.Second,
+	MaxDelay:     30 * time.Second,
+}
+
+type faucetRequest struct {
+	FixedAmountRequest struct {
+		Recipient string `json:"recipient"`
+	} `json:"FixedAmountRequest"`
+}
+
+type faucetCoin struct {
+	Amount           uint64 `json:"amount"`
+	ObjectId         string `json:"id"`
+	TransferTxDigest string `json:"transferTxDigest"`
+}
+
+type faucetResponse struct {
+	Error                 *string      `json:"error"`
+	TransferredGasObjects []faucetCoin `json:"transferredGasObjects"`
+}
+
+// RequestSui requests funds for address from network's faucet and returns
+// the ObjectRefs of the coins it sent, retrying with exponential backoff
+// (honoring the faucet's Retry-After header) for up to
+// DefaultConfig.MaxAttempts attempts in total.
+//
+// The faucet's own response doesn't include a coin's version or object
+// digest — only the digest of the transaction that funded it, which is a
+// different value — so the returned ObjectRefs always have Version 0 and a
+// zero Digest, and aren't safe to use as a transaction input as-is;
+// Client.RequestFaucet resolves both real fields via GetObject before
+// handing refs back. Call that instead unless you genuinely don't have a
+// Client to hand.
+func RequestSui(ctx context.Context, network Network, address types.Address) ([]types.ObjectRef, error) {
+	return RequestSuiWithConfig(ctx, network, address, DefaultConfig)
+}
+
+// RequestSuiWithConfig is RequestSui with custom retry behavior.
+func RequestSuiWithConfig(ctx context.Context, network Network, address types.Address, cfg Config) ([]types.ObjectRef, error) {
+	endpoint, ok := endpoints[network]
+	if !ok {
+		return nil, fmt.Errorf("faucet: unknown network %q", network)
+	}
+
+	body := faucetRequest{}
+	body.FixedAmountRequest.Recipient = address.ShortString()
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	delay := cfg.InitialDelay
+	var lastErr error
+	for attempt := 0; attempt < cfg.MaxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+			delay = nextDelay(delay, cfg.MaxDelay)
+		}
+
+		refs, retryAfter, err := requestOnce(ctx, endpoint, payload)
+		if err == nil {
+			return refs, nil
+		}
+		lastErr = err
+		if retryAfter > 0 {
+			delay = retryAfter
+		}
+	}
+	return nil, fmt.Errorf("faucet: giving up after %d attempt(s): %w", cfg.MaxAttempts, lastErr)
+}
+
+// requestOnce issues a single faucet request. retryAfter is non-zero only
+// when the faucet rate-limited the request and told us how long to wait.
+func requestOnce(ctx context.Context, endpoint string, payload []byte) (refs []types.ObjectRef, retryAfter time.Duration, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, retryAfterDelay(resp.Header.Get("Retry-After")), fmt.Errorf("faucet: rate limited")
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("faucet: HTTP %d: %s", resp.StatusCode, data)
+	}
+
+	var parsed faucetResponse
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, 0, err
+	}
+	if parsed.Error != nil && *parsed.Error != "" {
+		return nil, 0, fmt.Errorf("faucet: %s", *parsed.Error)
+	}
+
+	refs = make([]types.ObjectRef, len(parsed.TransferredGasObjects))
+	for i, coin := range parsed.TransferredGasObjects {
+		objId, err := types.NewAddressFromHex(coin.ObjectId)
+		if err != nil {
+			return nil, 0, err
+		}
+		refs[i] = types.ObjectRef{
+			ObjectId: *objId,
+			// Version and Digest are left zero: the faucet response only
+			// gives us the funding transaction's digest, not the coin
+			// object's own, and a wrong-but-valid-looking digest is worse
+			// than an obviously-unset one. See the doc comment above.
+		}
+	}
+	return refs, 0, nil
+}
+
+func retryAfterDelay(header string) time.Duration {
+	if header == "" {
+		return 0
+	}
+	if d, err := time.ParseDuration(header + "s"); err == nil {
+		return d
+	}
+	return 0
+}
+
+func nextDelay(delay, max time.Duration) time.Duration {
+	next := delay * 2
+	if next > max {
+		next = max
+	}
+	// Jitter so many CI runners backing off at once don't all retry on the
+	// same schedule and re-trip the rate limit together.
+	jitter := time.Duration(rand.Int63n(int64(next)/4 + 1))
+	return next + jitter
+}
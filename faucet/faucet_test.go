@@ -0,0 +1,88 @@
+package faucet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextDelayDoublesAndCapsWithJitter(t *testing.T) {
+	next := nextDelay(1*time.Second, 10*time.Second)
+	// Doubles to 2s, plus jitter up to next/4.
+	require.GreaterOrEqual(t, next, 2*time.Second)
+	require.LessOrEqual(t, next, 2*time.Second+500*time.Millisecond)
+
+	capped := nextDelay(8*time.Second, 10*time.Second)
+	// 8s would double to 16s, which exceeds the 10s cap.
+	require.GreaterOrEqual(t, capped, 10*time.Second)
+	require.LessOrEqual(t, capped, 10*time.Second+2500*time.Millisecond)
+}
+
+func TestRetryAfterDelay(t *testing.T) {
+	require.Equal(t, time.Duration(0), retryAfterDelay(""))
+	require.Equal(t, time.Duration(0), retryAfterDelay("not-a-number"))
+	require.Equal(t, 5*time.Second, retryAfterDelay("5"))
+}
+
+// TestRequestSuiWithConfigRetriesAfterRateLimit simulates a faucet that
+// rate-limits the first attempt (honoring Retry-After) and succeeds on the
+// second, confirming RequestSuiWithConfig backs off and retries rather than
+// giving up immediately.
+func TestRequestSuiWithConfigRetriesAfterRateLimit(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		resp := faucetResponse{
+			TransferredGasObjects: []faucetCoin{
+				{Amount: 1000, ObjectId: "0x01", TransferTxDigest: "dGVzdA=="},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	endpoints[Network("test")] = srv.URL
+	defer delete(endpoints, Network("test"))
+
+	addr, err := types.NewAddressFromHex("0x1")
+	require.NoError(t, err)
+
+	cfg := Config{MaxAttempts: 3, InitialDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+	refs, err := RequestSuiWithConfig(context.Background(), Network("test"), *addr, cfg)
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	require.EqualValues(t, 2, atomic.LoadInt32(&attempts))
+	// The faucet only tells us the funding transaction's digest, not the
+	// coin object's own, so it must come back zero rather than populated
+	// with that unrelated value.
+	require.Equal(t, "", refs[0].Digest)
+}
+
+func TestRequestSuiWithConfigGivesUpAfterMaxAttempts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	endpoints[Network("test2")] = srv.URL
+	defer delete(endpoints, Network("test2"))
+
+	addr, err := types.NewAddressFromHex("0x1")
+	require.NoError(t, err)
+
+	cfg := Config{MaxAttempts: 2, InitialDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	_, err = RequestSuiWithConfig(context.Background(), Network("test2"), *addr, cfg)
+	require.Error(t, err)
+}
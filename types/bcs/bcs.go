@@ -0,0 +1,167 @@
+// Package bcs implements Sui's Binary Canonical Serialization for the subset
+// of transaction types the SDK needs to build and sign transactions offline,
+// without round-tripping through a fullnode to obtain TxBytes.
+package bcs
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Encoder appends BCS-encoded values to an internal byte buffer.
+type Encoder struct {
+	buf []byte
+}
+
+func NewEncoder() *Encoder {
+	return &Encoder{}
+}
+
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+func (e *Encoder) WriteUint8(v uint8) {
+	e.buf = append(e.buf, v)
+}
+
+func (e *Encoder) WriteUint32(v uint32) {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	e.buf = append(e.buf, b[:]...)
+}
+
+func (e *Encoder) WriteUint64(v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	e.buf = append(e.buf, b[:]...)
+}
+
+// WriteULEB writes n as an unsigned LEB128 varint, the encoding BCS uses for
+// vector/string lengths and enum variant indices.
+func (e *Encoder) WriteULEB(n uint32) {
+	for {
+		b := byte(n & 0x7f)
+		n >>= 7
+		if n != 0 {
+			b |= 0x80
+		}
+		e.buf = append(e.buf, b)
+		if n == 0 {
+			return
+		}
+	}
+}
+
+// WriteBytes writes a ULEB128 length prefix followed by the raw bytes, BCS's
+// encoding for vector<u8> and any other variable-length byte sequence.
+func (e *Encoder) WriteBytes(b []byte) {
+	e.WriteULEB(uint32(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// WriteFixedBytes writes b with no length prefix, for fixed-size fields such
+// as addresses and object digests.
+func (e *Encoder) WriteFixedBytes(b []byte) {
+	e.buf = append(e.buf, b...)
+}
+
+// WriteOptional writes the BCS encoding of an Option<T>: a one-byte presence
+// flag followed by write() when present.
+func (e *Encoder) WriteOptional(present bool, write func()) {
+	if present {
+		e.WriteUint8(1)
+		write()
+	} else {
+		e.WriteUint8(0)
+	}
+}
+
+// Decoder reads BCS-encoded values back out of an in-memory buffer.
+type Decoder struct {
+	data []byte
+	pos  int
+}
+
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+var ErrUnexpectedEOF = errors.New("bcs: unexpected end of data")
+
+func (d *Decoder) ReadUint8() (uint8, error) {
+	if d.pos >= len(d.data) {
+		return 0, ErrUnexpectedEOF
+	}
+	v := d.data[d.pos]
+	d.pos++
+	return v, nil
+}
+
+func (d *Decoder) ReadUint32() (uint32, error) {
+	if d.pos+4 > len(d.data) {
+		return 0, ErrUnexpectedEOF
+	}
+	v := binary.LittleEndian.Uint32(d.data[d.pos : d.pos+4])
+	d.pos += 4
+	return v, nil
+}
+
+func (d *Decoder) ReadUint64() (uint64, error) {
+	if d.pos+8 > len(d.data) {
+		return 0, ErrUnexpectedEOF
+	}
+	v := binary.LittleEndian.Uint64(d.data[d.pos : d.pos+8])
+	d.pos += 8
+	return v, nil
+}
+
+func (d *Decoder) ReadULEB() (uint32, error) {
+	var result uint32
+	var shift uint
+	for {
+		b, err := d.ReadUint8()
+		if err != nil {
+			return 0, err
+		}
+		result |= uint32(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return result, nil
+		}
+		shift += 7
+	}
+}
+
+func (d *Decoder) ReadBytes() ([]byte, error) {
+	n, err := d.ReadULEB()
+	if err != nil {
+		return nil, err
+	}
+	return d.ReadFixedBytes(int(n))
+}
+
+func (d *Decoder) ReadFixedBytes(n int) ([]byte, error) {
+	if d.pos+n > len(d.data) {
+		return nil, ErrUnexpectedEOF
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *Decoder) ReadOptional(read func() error) (bool, error) {
+	present, err := d.ReadUint8()
+	if err != nil {
+		return false, err
+	}
+	if present == 0 {
+		return false, nil
+	}
+	return true, read()
+}
+
+// Remaining returns the number of unread bytes left in the decoder, useful
+// for callers that want to confirm a buffer was fully consumed.
+func (d *Decoder) Remaining() int {
+	return len(d.data) - d.pos
+}
@@ -0,0 +1,509 @@
+package types
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/coming-chat/go-sui/types/bcs"
+)
+
+// Variant indices for SingleTransactionKind, matching sui-types' BCS enum
+// ordering (sui/crates/sui-types/src/messages.rs).
+const (
+	txnKindTransferObject uint32 = iota
+	txnKindPublish
+	txnKindCall
+	txnKindTransferSui
+	txnKindChangeEpoch
+	txnKindSplitCoin
+)
+
+// digestLength is the byte width of a Sui transaction/object digest once
+// decoded from its base64 wire form.
+const digestLength = 32
+
+// Variant indices for CallArg and ObjectArg, matching sui-types' BCS enum
+// ordering.
+const (
+	callArgPure uint32 = iota
+	callArgObject
+)
+
+const (
+	objectArgImmOrOwned uint32 = iota
+	objectArgShared
+)
+
+// BCSArg is a Move call argument that knows how to BCS-encode its own
+// value. types/move.MoveValue satisfies this structurally: types can't
+// import types/move directly (types/move imports types), so this narrow
+// interface is how MoveCall.marshalBCS reaches that encoding without a
+// cycle.
+type BCSArg interface {
+	MarshalBCS(e *bcs.Encoder) error
+	Kind() string
+}
+
+// ObjectArgRef is implemented by BCSArg values with Kind() == "object" to
+// expose the full ObjectRef a CallArg::Object(ImmOrOwnedObject) needs
+// (id + version + digest); MarshalBCS alone only encodes the raw bytes
+// used by CallArg::Pure.
+type ObjectArgRef interface {
+	ObjectRef() ObjectRef
+}
+
+// rawPureArg and rawObjectArg satisfy BCSArg by replaying the exact bytes
+// captured while decoding a CallArg, so a MoveCall read off the wire can be
+// re-encoded without knowing the original Move value's Go type.
+type rawPureArg []byte
+
+func (v rawPureArg) MarshalBCS(e *bcs.Encoder) error { e.WriteFixedBytes(v); return nil }
+func (v rawPureArg) Kind() string                    { return "pure" }
+
+type rawObjectArg struct{ ref ObjectRef }
+
+func (v rawObjectArg) MarshalBCS(e *bcs.Encoder) error { return v.ref.marshalBCS(e) }
+func (v rawObjectArg) Kind() string                    { return "object" }
+func (v rawObjectArg) ObjectRef() ObjectRef            { return v.ref }
+
+func marshalCallArg(e *bcs.Encoder, arg BCSArg) error {
+	if objArg, ok := arg.(ObjectArgRef); ok {
+		e.WriteULEB(callArgObject)
+		e.WriteULEB(objectArgImmOrOwned)
+		return objArg.ObjectRef().marshalBCS(e)
+	}
+	e.WriteULEB(callArgPure)
+	sub := bcs.NewEncoder()
+	if err := arg.MarshalBCS(sub); err != nil {
+		return err
+	}
+	e.WriteBytes(sub.Bytes())
+	return nil
+}
+
+func unmarshalCallArg(d *bcs.Decoder) (BCSArg, error) {
+	variant, err := d.ReadULEB()
+	if err != nil {
+		return nil, err
+	}
+	switch variant {
+	case callArgPure:
+		raw, err := d.ReadBytes()
+		if err != nil {
+			return nil, err
+		}
+		return rawPureArg(raw), nil
+	case callArgObject:
+		objVariant, err := d.ReadULEB()
+		if err != nil {
+			return nil, err
+		}
+		if objVariant != objectArgImmOrOwned {
+			return nil, fmt.Errorf("bcs: ObjectArg variant %d (shared objects) is not supported", objVariant)
+		}
+		var ref ObjectRef
+		if err := ref.unmarshalBCS(d); err != nil {
+			return nil, err
+		}
+		return rawObjectArg{ref}, nil
+	default:
+		return nil, fmt.Errorf("bcs: unknown CallArg variant %d", variant)
+	}
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, returning the raw BCS
+// transaction bytes so a transaction can be written to disk or sent over an
+// air-gapped channel and later signed and submitted. No signature is
+// included in the returned bytes.
+func (txn *TransactionBytes) MarshalBinary() ([]byte, error) {
+	return txn.TxBytes.Data(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It only restores
+// TxBytes: Gas and InputObjects are RPC-side metadata, not part of the
+// canonical BCS encoding, so a fullnode call is still needed to repopulate
+// them if the caller needs that information back.
+func (txn *TransactionBytes) UnmarshalBinary(data []byte) error {
+	txn.TxBytes = Bytes(data).GetBase64Data()
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, producing the canonical
+// BCS encoding of the transaction data exactly as a fullnode would for
+// sui_moveCall and friends, so it can be signed and submitted without
+// trusting the node to report TxBytes honestly.
+func (s *SenderSignedData) MarshalBinary() ([]byte, error) {
+	if s.Sender == nil {
+		return nil, errors.New("bcs: SenderSignedData.Sender is required")
+	}
+	if s.GasPayment == nil {
+		return nil, errors.New("bcs: SenderSignedData.GasPayment is required")
+	}
+
+	e := bcs.NewEncoder()
+	e.WriteULEB(uint32(len(s.Transactions)))
+	for i := range s.Transactions {
+		if err := s.Transactions[i].marshalBCS(e); err != nil {
+			return nil, err
+		}
+	}
+	e.WriteFixedBytes(s.Sender.Data())
+	if err := s.GasPayment.marshalBCS(e); err != nil {
+		return nil, err
+	}
+	e.WriteUint64(s.GasBudget)
+	return e.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, the inverse of
+// MarshalBinary.
+func (s *SenderSignedData) UnmarshalBinary(data []byte) error {
+	d := bcs.NewDecoder(data)
+
+	n, err := d.ReadULEB()
+	if err != nil {
+		return err
+	}
+	s.Transactions = make([]SingleTransactionKind, n)
+	for i := range s.Transactions {
+		if err := s.Transactions[i].unmarshalBCS(d); err != nil {
+			return err
+		}
+	}
+
+	senderBytes, err := d.ReadFixedBytes(addressLength)
+	if err != nil {
+		return err
+	}
+	sender := HexData{data: senderBytes}
+	s.Sender = &sender
+
+	var gas ObjectRef
+	if err := gas.unmarshalBCS(d); err != nil {
+		return err
+	}
+	s.GasPayment = &gas
+
+	budget, err := d.ReadUint64()
+	if err != nil {
+		return err
+	}
+	s.GasBudget = budget
+	return nil
+}
+
+// marshalBCS encodes the ObjectRef the way sui-types does: ObjectId and
+// Digest are both fixed-size byte arrays, not length-prefixed blobs. Digest
+// arrives over JSON-RPC as base64 text, so it has to be decoded back to its
+// raw 32 bytes first, the same as ObjectId already is by the time it reaches
+// this struct.
+func (r ObjectRef) marshalBCS(e *bcs.Encoder) error {
+	e.WriteFixedBytes(r.ObjectId.Data())
+	e.WriteUint64(uint64(r.Version))
+	digest, err := base64.StdEncoding.DecodeString(r.Digest)
+	if err != nil {
+		return fmt.Errorf("bcs: ObjectRef.Digest: %w", err)
+	}
+	if len(digest) != digestLength {
+		return fmt.Errorf("bcs: ObjectRef.Digest: expected %d bytes, got %d", digestLength, len(digest))
+	}
+	e.WriteFixedBytes(digest)
+	return nil
+}
+
+func (r *ObjectRef) unmarshalBCS(d *bcs.Decoder) error {
+	idBytes, err := d.ReadFixedBytes(addressLength)
+	if err != nil {
+		return err
+	}
+	r.ObjectId = HexData{data: idBytes}
+
+	version, err := d.ReadUint64()
+	if err != nil {
+		return err
+	}
+	r.Version = int(version)
+
+	digestBytes, err := d.ReadFixedBytes(digestLength)
+	if err != nil {
+		return err
+	}
+	r.Digest = base64.StdEncoding.EncodeToString(digestBytes)
+	return nil
+}
+
+func (k *SingleTransactionKind) marshalBCS(e *bcs.Encoder) error {
+	switch {
+	case k.TransferObject != nil:
+		e.WriteULEB(txnKindTransferObject)
+		return k.TransferObject.marshalBCS(e)
+	case k.Publish != nil:
+		e.WriteULEB(txnKindPublish)
+		k.Publish.marshalBCS(e)
+	case k.Call != nil:
+		e.WriteULEB(txnKindCall)
+		return k.Call.marshalBCS(e)
+	case k.TransferSui != nil:
+		e.WriteULEB(txnKindTransferSui)
+		k.TransferSui.marshalBCS(e)
+	case k.ChangeEpoch != nil:
+		e.WriteULEB(txnKindChangeEpoch)
+		return k.ChangeEpoch.marshalBCS(e)
+	case k.SplitCoin != nil:
+		e.WriteULEB(txnKindSplitCoin)
+		k.SplitCoin.marshalBCS(e)
+	default:
+		return errors.New("bcs: SingleTransactionKind has no transaction set")
+	}
+	return nil
+}
+
+func (k *SingleTransactionKind) unmarshalBCS(d *bcs.Decoder) error {
+	variant, err := d.ReadULEB()
+	if err != nil {
+		return err
+	}
+	switch variant {
+	case txnKindTransferObject:
+		k.TransferObject = &TransferObject{}
+		return k.TransferObject.unmarshalBCS(d)
+	case txnKindPublish:
+		k.Publish = &ModulePublish{}
+		return k.Publish.unmarshalBCS(d)
+	case txnKindCall:
+		k.Call = &MoveCall{}
+		return k.Call.unmarshalBCS(d)
+	case txnKindTransferSui:
+		k.TransferSui = &TransferSui{}
+		return k.TransferSui.unmarshalBCS(d)
+	case txnKindChangeEpoch:
+		k.ChangeEpoch = &ChangeEpoch{}
+		return k.ChangeEpoch.unmarshalBCS(d)
+	case txnKindSplitCoin:
+		k.SplitCoin = &SplitCoin{}
+		return k.SplitCoin.unmarshalBCS(d)
+	default:
+		return fmt.Errorf("bcs: unknown SingleTransactionKind variant %d", variant)
+	}
+}
+
+func (t *TransferObject) marshalBCS(e *bcs.Encoder) error {
+	e.WriteFixedBytes(t.Recipient.Data())
+	return t.ObjectRef.marshalBCS(e)
+}
+
+func (t *TransferObject) unmarshalBCS(d *bcs.Decoder) error {
+	recipientBytes, err := d.ReadFixedBytes(addressLength)
+	if err != nil {
+		return err
+	}
+	t.Recipient = HexData{data: recipientBytes}
+	return t.ObjectRef.unmarshalBCS(d)
+}
+
+func (p *ModulePublish) marshalBCS(e *bcs.Encoder) {
+	e.WriteULEB(uint32(len(p.Modules)))
+	for _, m := range p.Modules {
+		e.WriteBytes(m)
+	}
+}
+
+func (p *ModulePublish) unmarshalBCS(d *bcs.Decoder) error {
+	n, err := d.ReadULEB()
+	if err != nil {
+		return err
+	}
+	p.Modules = make([][]byte, n)
+	for i := range p.Modules {
+		m, err := d.ReadBytes()
+		if err != nil {
+			return err
+		}
+		p.Modules[i] = m
+	}
+	return nil
+}
+
+func (t *TransferSui) marshalBCS(e *bcs.Encoder) {
+	e.WriteFixedBytes(t.Recipient.Data())
+	// A zero Amount means "transfer the whole balance of the gas object",
+	// which Sui encodes as Option::None; any other amount is Option::Some.
+	e.WriteOptional(t.Amount != 0, func() { e.WriteUint64(t.Amount) })
+}
+
+func (t *TransferSui) unmarshalBCS(d *bcs.Decoder) error {
+	recipientBytes, err := d.ReadFixedBytes(addressLength)
+	if err != nil {
+		return err
+	}
+	t.Recipient = HexData{data: recipientBytes}
+	_, err = d.ReadOptional(func() error {
+		amount, err := d.ReadUint64()
+		if err != nil {
+			return err
+		}
+		t.Amount = amount
+		return nil
+	})
+	return err
+}
+
+func (c *ChangeEpoch) marshalBCS(e *bcs.Encoder) error {
+	epoch, err := toUint64(c.Epoch)
+	if err != nil {
+		return fmt.Errorf("bcs: ChangeEpoch.Epoch: %w", err)
+	}
+	e.WriteUint64(epoch)
+	e.WriteUint64(c.StorageCharge)
+	e.WriteUint64(c.ComputationCharge)
+	return nil
+}
+
+func (c *ChangeEpoch) unmarshalBCS(d *bcs.Decoder) error {
+	epoch, err := d.ReadUint64()
+	if err != nil {
+		return err
+	}
+	c.Epoch = epoch
+
+	c.StorageCharge, err = d.ReadUint64()
+	if err != nil {
+		return err
+	}
+
+	c.ComputationCharge, err = d.ReadUint64()
+	return err
+}
+
+func toUint64(v interface{}) (uint64, error) {
+	switch n := v.(type) {
+	case uint64:
+		return n, nil
+	case int:
+		return uint64(n), nil
+	case int64:
+		return uint64(n), nil
+	case float64:
+		return uint64(n), nil
+	default:
+		return 0, fmt.Errorf("expected a numeric value, got %T", v)
+	}
+}
+
+func (s *SplitCoin) marshalBCS(e *bcs.Encoder) {
+	e.WriteFixedBytes(s.Coin.Data())
+	e.WriteULEB(uint32(len(s.SplitAmounts)))
+	for _, amount := range s.SplitAmounts {
+		e.WriteUint64(amount)
+	}
+}
+
+func (s *SplitCoin) unmarshalBCS(d *bcs.Decoder) error {
+	coinBytes, err := d.ReadFixedBytes(addressLength)
+	if err != nil {
+		return err
+	}
+	s.Coin = HexData{data: coinBytes}
+
+	n, err := d.ReadULEB()
+	if err != nil {
+		return err
+	}
+	s.SplitAmounts = make([]uint64, n)
+	for i := range s.SplitAmounts {
+		s.SplitAmounts[i], err = d.ReadUint64()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// marshalBCS encodes a MoveCall exactly as a fullnode would: package,
+// module, and function, followed by real TypeTag and CallArg encodings for
+// the type/call arguments (see types/type_tag.go and the CallArg helpers
+// above). It requires BCSTypeArgs/BCSArgs to be populated by
+// TransactionBuilder.AddMoveCall or unmarshalBCS; a MoveCall assembled by
+// hand with only the JSON-facing TypeArgs/Args set can't be BCS-encoded,
+// since CallArg's wire form carries no type information to derive from a
+// bare interface{}.
+func (c *MoveCall) marshalBCS(e *bcs.Encoder) error {
+	if len(c.BCSTypeArgs) != len(c.TypeArgs) || len(c.BCSArgs) != len(c.Args) {
+		return errors.New("bcs: MoveCall has untyped TypeArgs/Args; build calls via TransactionBuilder.AddMoveCall so they can be BCS-encoded")
+	}
+
+	if err := c.Package.marshalBCS(e); err != nil {
+		return err
+	}
+	e.WriteBytes([]byte(c.Module))
+	e.WriteBytes([]byte(c.Function))
+
+	e.WriteULEB(uint32(len(c.BCSTypeArgs)))
+	for i := range c.BCSTypeArgs {
+		if err := c.BCSTypeArgs[i].marshalBCS(e); err != nil {
+			return fmt.Errorf("bcs: MoveCall type argument %d: %w", i, err)
+		}
+	}
+
+	e.WriteULEB(uint32(len(c.BCSArgs)))
+	for i, arg := range c.BCSArgs {
+		if err := marshalCallArg(e, arg); err != nil {
+			return fmt.Errorf("bcs: MoveCall argument %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *MoveCall) unmarshalBCS(d *bcs.Decoder) error {
+	if err := c.Package.unmarshalBCS(d); err != nil {
+		return err
+	}
+	module, err := d.ReadBytes()
+	if err != nil {
+		return err
+	}
+	c.Module = string(module)
+
+	function, err := d.ReadBytes()
+	if err != nil {
+		return err
+	}
+	c.Function = string(function)
+
+	n, err := d.ReadULEB()
+	if err != nil {
+		return err
+	}
+	c.BCSTypeArgs = make([]TypeTag, n)
+	c.TypeArgs = make([]interface{}, n)
+	for i := range c.BCSTypeArgs {
+		tag, err := unmarshalTypeTag(d)
+		if err != nil {
+			return fmt.Errorf("bcs: MoveCall type argument %d: %w", i, err)
+		}
+		c.BCSTypeArgs[i] = tag
+		c.TypeArgs[i] = tag.String()
+	}
+
+	m, err := d.ReadULEB()
+	if err != nil {
+		return err
+	}
+	c.BCSArgs = make([]BCSArg, m)
+	c.Args = make([]interface{}, m)
+	for i := range c.BCSArgs {
+		arg, err := unmarshalCallArg(d)
+		if err != nil {
+			return fmt.Errorf("bcs: MoveCall argument %d: %w", i, err)
+		}
+		c.BCSArgs[i] = arg
+		switch v := arg.(type) {
+		case rawObjectArg:
+			c.Args[i] = v.ref
+		case rawPureArg:
+			c.Args[i] = []byte(v)
+		}
+	}
+	return nil
+}
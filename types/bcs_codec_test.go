@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/coming-chat/go-sui/types/bcs"
+	"github.com/stretchr/testify/require"
+)
+
+func addr(b byte) Address {
+	data := make([]byte, addressLength)
+	data[addressLength-1] = b
+	return Address{data: data}
+}
+
+func digest(b byte) string {
+	raw := make([]byte, digestLength)
+	for i := range raw {
+		raw[i] = b
+	}
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+// TestSenderSignedDataBCSGolden pins the exact byte layout of a simple
+// TransferSui transaction against hand-computed bytes, so a change to any
+// field's encoding (ordering, fixed vs. length-prefixed, variant index) is
+// caught even if the round trip below still passes.
+func TestSenderSignedDataBCSGolden(t *testing.T) {
+	sender := addr(0x01)
+	gasId := addr(0x02)
+	recipient := addr(0x03)
+	gasDigest := digest(0x09)
+
+	data := SenderSignedData{
+		Transactions: []SingleTransactionKind{
+			{TransferSui: &TransferSui{Recipient: recipient, Amount: 1000}},
+		},
+		Sender: &sender,
+		GasPayment: &ObjectRef{
+			ObjectId: gasId,
+			Version:  7,
+			Digest:   gasDigest,
+		},
+		GasBudget: 5000,
+	}
+
+	got, err := data.MarshalBinary()
+	require.NoError(t, err)
+
+	var want []byte
+	want = append(want, 0x01)                         // 1 transaction
+	want = append(want, 0x03)                         // TransferSui variant
+	want = append(want, recipient.Data()...)          // recipient address
+	want = append(want, 0x01)                         // Option::Some(amount)
+	want = append(want, 0xe8, 0x03, 0, 0, 0, 0, 0, 0) // amount = 1000 LE u64
+	want = append(want, sender.Data()...)             // sender address
+	want = append(want, gasId.Data()...)              // gas ObjectId
+	want = append(want, 0x07, 0, 0, 0, 0, 0, 0, 0)    // gas version = 7
+	rawDigest, err := base64.StdEncoding.DecodeString(gasDigest)
+	require.NoError(t, err)
+	want = append(want, rawDigest...)                 // gas digest, fixed 32 bytes
+	want = append(want, 0x88, 0x13, 0, 0, 0, 0, 0, 0) // gas budget = 5000 LE u64
+
+	require.Equal(t, want, got)
+}
+
+// TestObjectRefBCSRoundTrip checks that Digest, which is base64 text over
+// JSON-RPC, is decoded to a fixed 32-byte array, not BCS-encoded as a
+// length-prefixed blob of its raw ASCII characters.
+func TestObjectRefBCSRoundTrip(t *testing.T) {
+	ref := ObjectRef{
+		ObjectId: addr(0x2a),
+		Version:  42,
+		Digest:   digest(0xaa),
+	}
+
+	e := bcs.NewEncoder()
+	require.NoError(t, ref.marshalBCS(e))
+	// ObjectId (20) + Version (8) + Digest (32), no length prefixes.
+	require.Len(t, e.Bytes(), addressLength+8+digestLength)
+
+	var got ObjectRef
+	require.NoError(t, got.unmarshalBCS(bcs.NewDecoder(e.Bytes())))
+	require.Equal(t, ref, got)
+}
+
+// pureU64Arg is a minimal BCSArg so this test doesn't need to import
+// types/move (which imports types, so it can't be imported back from here).
+type pureU64Arg uint64
+
+func (v pureU64Arg) MarshalBCS(e *bcs.Encoder) error { e.WriteUint64(uint64(v)); return nil }
+func (v pureU64Arg) Kind() string                    { return "u64" }
+
+// TestMoveCallBCSRoundTrip builds a MoveCall through the same BCSArg path
+// TransactionBuilder.AddMoveCall uses and checks it decodes back to an
+// equivalent call, covering both a Pure (u64) and an Object argument.
+func TestMoveCallBCSRoundTrip(t *testing.T) {
+	pkg := ObjectRef{ObjectId: addr(0x02), Version: 1, Digest: digest(0x01)}
+	objArg := ObjectRef{ObjectId: addr(0x05), Version: 3, Digest: digest(0x02)}
+
+	call := MoveCall{
+		Package:     pkg,
+		Module:      "coin",
+		Function:    "transfer",
+		TypeArgs:    []interface{}{"0x2::sui::SUI"},
+		Args:        []interface{}{uint64(42), objArg},
+		BCSTypeArgs: []TypeTag{{Kind: "struct", Struct: &StructTag{Address: "0x2", Module: "sui", Name: "SUI"}}},
+		BCSArgs:     []BCSArg{pureU64Arg(42), rawObjectArg{objArg}},
+	}
+
+	e := bcs.NewEncoder()
+	require.NoError(t, call.marshalBCS(e))
+
+	var got MoveCall
+	require.NoError(t, got.unmarshalBCS(bcs.NewDecoder(e.Bytes())))
+
+	require.Equal(t, call.Module, got.Module)
+	require.Equal(t, call.Function, got.Function)
+	require.Equal(t, []interface{}{"0x2::sui::SUI"}, got.TypeArgs)
+	require.Equal(t, objArg, got.Args[1])
+
+	// Re-encoding the decoded call must reproduce identical bytes.
+	e2 := bcs.NewEncoder()
+	require.NoError(t, got.marshalBCS(e2))
+	require.Equal(t, e.Bytes(), e2.Bytes())
+}
+
+// TestMoveCallBCSRejectsUntyped confirms MoveCall.marshalBCS fails loudly
+// instead of silently producing wrong bytes when BCSArgs/BCSTypeArgs
+// weren't set (e.g. a MoveCall assembled by hand from JSON-facing fields
+// alone).
+func TestMoveCallBCSRejectsUntyped(t *testing.T) {
+	call := MoveCall{
+		Package:  ObjectRef{ObjectId: addr(0x02), Version: 1, Digest: digest(0x01)},
+		Module:   "coin",
+		Function: "transfer",
+		Args:     []interface{}{uint64(42)},
+	}
+	e := bcs.NewEncoder()
+	require.Error(t, call.marshalBCS(e))
+}
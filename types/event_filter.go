@@ -0,0 +1,82 @@
+package types
+
+// EventFilter selects which events a sui_subscribeEvent subscription
+// receives. It mirrors the tagged-union EventFilter the Sui JSON-RPC API
+// expects: exactly one field is set per value, so it marshals to JSON the
+// same way ObjectOwner's internal variants do, as a single-key object like
+// {"Package": "0x2"} or {"And": [...]}. Build values with the New*Filter
+// constructors and the All/Any/And/Or combinators rather than populating
+// the struct directly.
+type EventFilter struct {
+	Package       *ObjectId `json:"Package,omitempty"`
+	Module        string    `json:"Module,omitempty"`
+	Sender        *Address  `json:"Sender,omitempty"`
+	Recipient     *Address  `json:"Recipient,omitempty"`
+	MoveEventType string    `json:"MoveEventType,omitempty"`
+
+	All *[]EventFilter  `json:"All,omitempty"`
+	Any *[]EventFilter  `json:"Any,omitempty"`
+	And *[2]EventFilter `json:"And,omitempty"`
+	Or  *[2]EventFilter `json:"Or,omitempty"`
+}
+
+// NewPackageEventFilter matches events emitted by pkg.
+func NewPackageEventFilter(pkg ObjectId) EventFilter {
+	return EventFilter{Package: &pkg}
+}
+
+// NewModuleEventFilter matches events emitted by the named Move module.
+func NewModuleEventFilter(module string) EventFilter {
+	return EventFilter{Module: module}
+}
+
+// NewSenderEventFilter matches events from transactions sent by sender.
+func NewSenderEventFilter(sender Address) EventFilter {
+	return EventFilter{Sender: &sender}
+}
+
+// NewRecipientEventFilter matches events affecting objects owned by recipient.
+func NewRecipientEventFilter(recipient Address) EventFilter {
+	return EventFilter{Recipient: &recipient}
+}
+
+// NewMoveEventTypeFilter matches events of the given Move event struct type,
+// e.g. "0x2::coin::CoinCreated".
+func NewMoveEventTypeFilter(moveEventType string) EventFilter {
+	return EventFilter{MoveEventType: moveEventType}
+}
+
+// AllEventFilter matches events that satisfy every filter in filters.
+func AllEventFilter(filters ...EventFilter) EventFilter {
+	return EventFilter{All: &filters}
+}
+
+// AnyEventFilter matches events that satisfy at least one filter in filters.
+func AnyEventFilter(filters ...EventFilter) EventFilter {
+	return EventFilter{Any: &filters}
+}
+
+// AndEventFilter matches events that satisfy both a and b.
+func AndEventFilter(a, b EventFilter) EventFilter {
+	return EventFilter{And: &[2]EventFilter{a, b}}
+}
+
+// OrEventFilter matches events that satisfy either a or b.
+func OrEventFilter(a, b EventFilter) EventFilter {
+	return EventFilter{Or: &[2]EventFilter{a, b}}
+}
+
+// EventID identifies an event's position in a transaction's effects.
+type EventID struct {
+	TxSeq    int64 `json:"txSeq"`
+	EventSeq int64 `json:"eventSeq"`
+}
+
+// SuiEventEnvelope is a single event delivered by sui_subscribeEvent, as well
+// as the element type returned by event query RPCs.
+type SuiEventEnvelope struct {
+	Timestamp int64   `json:"timestamp"`
+	TxDigest  string  `json:"txDigest"`
+	Id        EventID `json:"id"`
+	Event     Event   `json:"event"`
+}
@@ -0,0 +1,248 @@
+// Package move provides typed encodings for Move call arguments. Without
+// it, callers of Client.MoveCall have to guess how a vector<u8>, a
+// u64-as-string, an ObjectId, or a nested struct should be rendered as JSON
+// for the RPC (and, for the offline builder, as BCS bytes).
+package move
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/coming-chat/go-sui/types/bcs"
+)
+
+// MoveValue is a single Move call argument. It knows how to render itself
+// both as the JSON form sui_moveCall expects and as BCS bytes for the
+// offline transaction builder, and reports a coarse Kind used to validate
+// arguments against a function's normalized ABI.
+type MoveValue interface {
+	JSON() (interface{}, error)
+	MarshalBCS(e *bcs.Encoder) error
+	Kind() string
+}
+
+type u8Value uint8
+
+// U8 constructs a Move u8 argument.
+func U8(v uint8) MoveValue { return u8Value(v) }
+
+func (v u8Value) JSON() (interface{}, error)      { return uint8(v), nil }
+func (v u8Value) MarshalBCS(e *bcs.Encoder) error { e.WriteUint8(uint8(v)); return nil }
+func (v u8Value) Kind() string                    { return "u8" }
+
+type u64Value uint64
+
+// U64 constructs a Move u64 argument. It's rendered as a JSON string since
+// u64 doesn't fit losslessly in a JSON number.
+func U64(v uint64) MoveValue { return u64Value(v) }
+
+func (v u64Value) JSON() (interface{}, error) { return fmt.Sprintf("%d", uint64(v)), nil }
+func (v u64Value) MarshalBCS(e *bcs.Encoder) error {
+	e.WriteUint64(uint64(v))
+	return nil
+}
+func (v u64Value) Kind() string { return "u64" }
+
+type boolValue bool
+
+// Bool constructs a Move bool argument.
+func Bool(v bool) MoveValue { return boolValue(v) }
+
+func (v boolValue) JSON() (interface{}, error) { return bool(v), nil }
+func (v boolValue) MarshalBCS(e *bcs.Encoder) error {
+	if v {
+		e.WriteUint8(1)
+	} else {
+		e.WriteUint8(0)
+	}
+	return nil
+}
+func (v boolValue) Kind() string { return "bool" }
+
+type addressValue struct{ addr types.Address }
+
+// Address constructs a Move address argument.
+func Address(addr types.Address) MoveValue { return addressValue{addr} }
+
+func (v addressValue) JSON() (interface{}, error)      { return v.addr.ShortString(), nil }
+func (v addressValue) MarshalBCS(e *bcs.Encoder) error { e.WriteFixedBytes(v.addr.Data()); return nil }
+func (v addressValue) Kind() string                    { return "address" }
+
+type objectArgValue struct{ ref types.ObjectRef }
+
+// ObjectArg constructs a Move argument referring to an on-chain object. The
+// full ObjectRef (not just its ID) is required because a CallArg::Object
+// needs the object's version and digest to tell a fullnode exactly which
+// version of the object this call was built against.
+func ObjectArg(ref types.ObjectRef) MoveValue { return objectArgValue{ref} }
+
+func (v objectArgValue) JSON() (interface{}, error) { return v.ref.ObjectId.ShortString(), nil }
+func (v objectArgValue) MarshalBCS(e *bcs.Encoder) error {
+	e.WriteFixedBytes(v.ref.ObjectId.Data())
+	return nil
+}
+func (v objectArgValue) Kind() string { return "object" }
+
+// ObjectRef satisfies types.ObjectArgRef, giving MoveCall.marshalBCS access
+// to the version and digest a CallArg::Object(ImmOrOwnedObject) needs,
+// which MarshalBCS alone (used for values nested inside a vector or struct)
+// doesn't encode.
+func (v objectArgValue) ObjectRef() types.ObjectRef { return v.ref }
+
+type bytesValue []byte
+
+// Bytes constructs a Move vector<u8> argument from raw bytes.
+func Bytes(b []byte) MoveValue { return bytesValue(b) }
+
+// JSON renders each byte as its own element, matching the shape
+// Vector(U8(...), ...).JSON() produces: encoding/json would otherwise
+// base64-encode a bare []byte into a JSON string, which is a different
+// wire shape than the array sui_moveCall expects for a vector<u8>.
+func (v bytesValue) JSON() (interface{}, error) {
+	out := make([]interface{}, len(v))
+	for i, b := range v {
+		out[i] = b
+	}
+	return out, nil
+}
+func (v bytesValue) MarshalBCS(e *bcs.Encoder) error { e.WriteBytes(v); return nil }
+func (v bytesValue) Kind() string                    { return "vector" }
+
+type vectorValue struct{ elems []MoveValue }
+
+// Vector constructs a Move vector<T> argument from its elements.
+func Vector(elems ...MoveValue) MoveValue { return vectorValue{elems} }
+
+func (v vectorValue) JSON() (interface{}, error) {
+	out := make([]interface{}, len(v.elems))
+	for i, elem := range v.elems {
+		j, err := elem.JSON()
+		if err != nil {
+			return nil, fmt.Errorf("move: vector element %d: %w", i, err)
+		}
+		out[i] = j
+	}
+	return out, nil
+}
+
+func (v vectorValue) MarshalBCS(e *bcs.Encoder) error {
+	e.WriteULEB(uint32(len(v.elems)))
+	for i, elem := range v.elems {
+		if err := elem.MarshalBCS(e); err != nil {
+			return fmt.Errorf("move: vector element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (v vectorValue) Kind() string { return "vector" }
+
+type structValue struct{ fields []MoveValue }
+
+// Struct constructs a Move struct argument from its fields in declaration
+// order. Move structs don't carry field names over the wire, so only order
+// matters.
+func Struct(fields ...MoveValue) MoveValue { return structValue{fields} }
+
+func (v structValue) JSON() (interface{}, error) {
+	out := make([]interface{}, len(v.fields))
+	for i, f := range v.fields {
+		j, err := f.JSON()
+		if err != nil {
+			return nil, fmt.Errorf("move: struct field %d: %w", i, err)
+		}
+		out[i] = j
+	}
+	return out, nil
+}
+
+func (v structValue) MarshalBCS(e *bcs.Encoder) error {
+	for i, f := range v.fields {
+		if err := f.MarshalBCS(e); err != nil {
+			return fmt.Errorf("move: struct field %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func (v structValue) Kind() string { return "struct" }
+
+var (
+	hexDataType   = reflect.TypeOf(types.HexData{})
+	objectRefType = reflect.TypeOf(types.ObjectRef{})
+)
+
+// From converts an ordinary Go value into a MoveValue using reflection, so
+// callers can pass Go structs tagged `move:"..."` instead of building a
+// MoveValue tree by hand. The move tag isn't required: Move structs are
+// encoded positionally, so field order is what matters, not field names.
+// The only tag value with an effect is move:"-", which drops the field.
+func From(v interface{}) (MoveValue, error) {
+	return fromValue(reflect.ValueOf(v))
+}
+
+func fromValue(rv reflect.Value) (MoveValue, error) {
+	if !rv.IsValid() {
+		return nil, fmt.Errorf("move: cannot convert a nil value")
+	}
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("move: cannot convert a nil pointer")
+		}
+		return fromValue(rv.Elem())
+	}
+	if rv.Type() == objectRefType {
+		return ObjectArg(rv.Interface().(types.ObjectRef)), nil
+	}
+	if rv.Type() == hexDataType {
+		// A bare HexData has no version/digest, so it can't become a full
+		// object argument; treat it as an address instead. Pass an
+		// ObjectRef explicitly to build an object argument.
+		return Address(rv.Interface().(types.HexData)), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Uint8:
+		return U8(uint8(rv.Uint())), nil
+	case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return U64(rv.Uint()), nil
+	case reflect.Bool:
+		return Bool(rv.Bool()), nil
+	case reflect.String:
+		return Bytes([]byte(rv.String())), nil
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8 {
+			return Bytes(rv.Bytes()), nil
+		}
+		elems := make([]MoveValue, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			elem, err := fromValue(rv.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("move: element %d: %w", i, err)
+			}
+			elems[i] = elem
+		}
+		return Vector(elems...), nil
+	case reflect.Struct:
+		t := rv.Type()
+		var fields []MoveValue
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue // unexported
+			}
+			if f.Tag.Get("move") == "-" {
+				continue
+			}
+			val, err := fromValue(rv.Field(i))
+			if err != nil {
+				return nil, fmt.Errorf("move: field %s: %w", f.Name, err)
+			}
+			fields = append(fields, val)
+		}
+		return Struct(fields...), nil
+	default:
+		return nil, fmt.Errorf("move: unsupported argument type %s", rv.Type())
+	}
+}
@@ -0,0 +1,89 @@
+package move
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/coming-chat/go-sui/types/bcs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromPrimitives(t *testing.T) {
+	cases := []struct {
+		name string
+		in   interface{}
+		kind string
+	}{
+		{"uint8", uint8(7), "u8"},
+		{"uint64", uint64(7), "u64"},
+		{"bool", true, "bool"},
+		{"string", "hello", "vector"},
+		{"byte slice", []byte{1, 2, 3}, "vector"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v, err := From(c.in)
+			require.NoError(t, err)
+			require.Equal(t, c.kind, v.Kind())
+		})
+	}
+}
+
+func TestFromObjectRef(t *testing.T) {
+	ref := types.ObjectRef{Version: 3, Digest: "d"}
+	v, err := From(ref)
+	require.NoError(t, err)
+	require.Equal(t, "object", v.Kind())
+
+	objArg, ok := v.(interface{ ObjectRef() types.ObjectRef })
+	require.True(t, ok)
+	require.Equal(t, ref, objArg.ObjectRef())
+}
+
+func TestFromStruct(t *testing.T) {
+	type payload struct {
+		Amount uint64
+		Active bool
+		Hidden string `move:"-"`
+	}
+	v, err := From(payload{Amount: 10, Active: true, Hidden: "skip-me"})
+	require.NoError(t, err)
+	require.Equal(t, "struct", v.Kind())
+
+	json, err := v.JSON()
+	require.NoError(t, err)
+	// Hidden is tagged move:"-" and must be dropped, so only 2 fields remain.
+	require.Len(t, json, 2)
+}
+
+func TestFromNilPointer(t *testing.T) {
+	var p *int
+	_, err := From(p)
+	require.Error(t, err)
+}
+
+func TestVectorJSONAndBCS(t *testing.T) {
+	v := Vector(U8(1), U8(2), U8(3))
+	j, err := v.JSON()
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint8(1), uint8(2), uint8(3)}, j)
+
+	e := bcs.NewEncoder()
+	require.NoError(t, v.MarshalBCS(e))
+	// ULEB128 length 3, then one byte per u8 element.
+	require.Equal(t, []byte{3, 1, 2, 3}, e.Bytes())
+}
+
+// TestBytesJSONMatchesVectorShape checks that Bytes(...).JSON() yields the
+// array-of-bytes shape every other Kind() == "vector" value produces, not a
+// raw []byte, which encoding/json would serialize as a base64 string.
+func TestBytesJSONMatchesVectorShape(t *testing.T) {
+	j, err := Bytes([]byte{1, 2, 3}).JSON()
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{byte(1), byte(2), byte(3)}, j)
+
+	encoded, err := json.Marshal(j)
+	require.NoError(t, err)
+	require.Equal(t, "[1,2,3]", string(encoded))
+}
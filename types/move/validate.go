@@ -0,0 +1,62 @@
+package move
+
+import (
+	"fmt"
+
+	"github.com/coming-chat/go-sui/types"
+)
+
+// ValidateArgs checks args against fn's declared parameters, catching
+// obvious mistakes (wrong arity, a vector where a primitive was expected)
+// before the call ever reaches the fullnode. It does not fully type-check
+// generic or nested struct parameters.
+func ValidateArgs(fn *types.SuiMoveNormalizedFunction, args []MoveValue) error {
+	params := fn.Parameters
+	// Entry functions almost always take a trailing &mut TxContext that
+	// callers never pass explicitly.
+	if len(params) > 0 && isTxContext(params[len(params)-1]) {
+		params = params[:len(params)-1]
+	}
+	if len(args) != len(params) {
+		return fmt.Errorf("move: function expects %d argument(s), got %d", len(params), len(args))
+	}
+	for i, p := range params {
+		if err := kindMatches(p, args[i].Kind()); err != nil {
+			return fmt.Errorf("move: argument %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func isTxContext(t types.SuiMoveNormalizedType) bool {
+	ref := t.MutableReference
+	if ref == nil {
+		ref = t.Reference
+	}
+	return ref != nil && ref.Struct != nil && ref.Struct.Name == "TxContext"
+}
+
+func kindMatches(p types.SuiMoveNormalizedType, argKind string) error {
+	switch p.Kind {
+	case "U8", "U16", "U32", "U64", "U128", "U256":
+		if argKind != "u64" && argKind != "u8" {
+			return fmt.Errorf("expected a numeric argument for %s, got %s", p.Kind, argKind)
+		}
+	case "Bool":
+		if argKind != "bool" {
+			return fmt.Errorf("expected bool, got %s", argKind)
+		}
+	case "Address", "Signer":
+		if argKind != "address" && argKind != "object" {
+			return fmt.Errorf("expected an address, got %s", argKind)
+		}
+	case "":
+		switch {
+		case p.Vector != nil && argKind != "vector":
+			return fmt.Errorf("expected vector<%s>, got %s", p.Vector.Kind, argKind)
+		case p.Struct != nil && argKind != "struct" && argKind != "object":
+			return fmt.Errorf("expected struct %s, got %s", p.Struct.Name, argKind)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package move
+
+import (
+	"testing"
+
+	"github.com/coming-chat/go-sui/types"
+	"github.com/stretchr/testify/require"
+)
+
+func fn(params ...types.SuiMoveNormalizedType) *types.SuiMoveNormalizedFunction {
+	return &types.SuiMoveNormalizedFunction{Parameters: params}
+}
+
+func TestValidateArgsArity(t *testing.T) {
+	err := ValidateArgs(fn(types.SuiMoveNormalizedType{Kind: "U64"}), nil)
+	require.Error(t, err)
+}
+
+func TestValidateArgsKindMismatch(t *testing.T) {
+	err := ValidateArgs(fn(types.SuiMoveNormalizedType{Kind: "Bool"}), []MoveValue{U64(1)})
+	require.Error(t, err)
+}
+
+func TestValidateArgsOK(t *testing.T) {
+	err := ValidateArgs(
+		fn(types.SuiMoveNormalizedType{Kind: "U64"}, types.SuiMoveNormalizedType{Kind: "Bool"}),
+		[]MoveValue{U64(1), Bool(true)},
+	)
+	require.NoError(t, err)
+}
+
+func TestValidateArgsDropsTrailingTxContext(t *testing.T) {
+	txCtx := types.SuiMoveNormalizedType{
+		MutableReference: &types.SuiMoveNormalizedType{
+			Struct: &types.SuiMoveNormalizedStructType{Name: "TxContext"},
+		},
+	}
+	err := ValidateArgs(
+		fn(types.SuiMoveNormalizedType{Kind: "U64"}, txCtx),
+		[]MoveValue{U64(1)},
+	)
+	require.NoError(t, err)
+}
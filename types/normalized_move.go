@@ -0,0 +1,101 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// SuiMoveNormalizedFunction is a Move entry function's normalized ABI, as
+// returned by sui_getNormalizedMoveFunction.
+type SuiMoveNormalizedFunction struct {
+	Visibility     string                  `json:"visibility"`
+	IsEntry        bool                    `json:"isEntry"`
+	TypeParameters []SuiMoveAbilitySet     `json:"typeParameters"`
+	Parameters     []SuiMoveNormalizedType `json:"parameters"`
+	Return         []SuiMoveNormalizedType `json:"return"`
+}
+
+type SuiMoveAbilitySet struct {
+	Abilities []string `json:"abilities"`
+}
+
+// SuiMoveNormalizedType is a Move type as reported by a normalized ABI. The
+// fullnode represents it as an untagged enum: a bare string for primitive
+// kinds ("U8", "Bool", "Address", ...) or a single-key object for composite
+// kinds (Vector, Reference, MutableReference, Struct, TypeParameter), so it
+// needs the same sniff-the-bytes (Un)MarshalJSON approach as ObjectOwner.
+type SuiMoveNormalizedType struct {
+	// Kind holds the primitive type name when this is not a composite type,
+	// e.g. "U8", "Bool", "Address", "Signer".
+	Kind string
+
+	Vector           *SuiMoveNormalizedType       `json:"-"`
+	Reference        *SuiMoveNormalizedType       `json:"-"`
+	MutableReference *SuiMoveNormalizedType       `json:"-"`
+	Struct           *SuiMoveNormalizedStructType `json:"-"`
+	TypeParameter    *uint16                      `json:"-"`
+}
+
+type SuiMoveNormalizedStructType struct {
+	Address       string                  `json:"address"`
+	Module        string                  `json:"module"`
+	Name          string                  `json:"name"`
+	TypeArguments []SuiMoveNormalizedType `json:"typeArguments"`
+}
+
+func (t *SuiMoveNormalizedType) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(data, []byte("\"")) {
+		var kind string
+		if err := json.Unmarshal(data, &kind); err != nil {
+			return err
+		}
+		t.Kind = kind
+		return nil
+	}
+	var composite struct {
+		Vector           *SuiMoveNormalizedType       `json:"Vector,omitempty"`
+		Reference        *SuiMoveNormalizedType       `json:"Reference,omitempty"`
+		MutableReference *SuiMoveNormalizedType       `json:"MutableReference,omitempty"`
+		Struct           *SuiMoveNormalizedStructType `json:"Struct,omitempty"`
+		TypeParameter    *uint16                      `json:"TypeParameter,omitempty"`
+	}
+	if err := json.Unmarshal(data, &composite); err != nil {
+		return err
+	}
+	t.Vector = composite.Vector
+	t.Reference = composite.Reference
+	t.MutableReference = composite.MutableReference
+	t.Struct = composite.Struct
+	t.TypeParameter = composite.TypeParameter
+	return nil
+}
+
+func (t SuiMoveNormalizedType) MarshalJSON() ([]byte, error) {
+	switch {
+	case t.Kind != "":
+		return json.Marshal(t.Kind)
+	case t.Vector != nil:
+		return json.Marshal(struct {
+			Vector *SuiMoveNormalizedType `json:"Vector"`
+		}{t.Vector})
+	case t.Reference != nil:
+		return json.Marshal(struct {
+			Reference *SuiMoveNormalizedType `json:"Reference"`
+		}{t.Reference})
+	case t.MutableReference != nil:
+		return json.Marshal(struct {
+			MutableReference *SuiMoveNormalizedType `json:"MutableReference"`
+		}{t.MutableReference})
+	case t.Struct != nil:
+		return json.Marshal(struct {
+			Struct *SuiMoveNormalizedStructType `json:"Struct"`
+		}{t.Struct})
+	case t.TypeParameter != nil:
+		return json.Marshal(struct {
+			TypeParameter *uint16 `json:"TypeParameter"`
+		}{t.TypeParameter})
+	default:
+		return nil, errors.New("types: empty SuiMoveNormalizedType")
+	}
+}
@@ -0,0 +1,180 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coming-chat/go-sui/types/bcs"
+)
+
+// TypeTag is a Move type, BCS-encoded as sui-types' recursive TypeTag enum
+// (bool/u8/u16/u32/u64/u128/u256/address/signer/vector/struct). It only
+// covers the forms MoveCall.TypeArgs needs to reach a fullnode: generic
+// struct type arguments (e.g. "0x2::coin::Coin<0x2::sui::SUI>") are
+// rejected by ParseTypeTag rather than silently mis-encoded.
+type TypeTag struct {
+	Kind   string // "bool", "u8", "u16", "u32", "u64", "u128", "u256", "address", "signer", "vector", "struct"
+	Vector *TypeTag
+	Struct *StructTag
+}
+
+// StructTag identifies a non-generic Move struct by its fully-qualified
+// on-chain address, e.g. 0x2::sui::SUI.
+type StructTag struct {
+	Address string
+	Module  string
+	Name    string
+}
+
+const (
+	typeTagBool uint32 = iota
+	typeTagU8
+	typeTagU64
+	typeTagU128
+	typeTagAddress
+	typeTagSigner
+	typeTagVector
+	typeTagStruct
+	typeTagU16
+	typeTagU32
+	typeTagU256
+)
+
+// ParseTypeTag parses a Move type string such as "u64", "address", or
+// "0x2::sui::SUI" into a TypeTag.
+func ParseTypeTag(s string) (TypeTag, error) {
+	switch s {
+	case "bool", "u8", "u16", "u32", "u64", "u128", "u256", "address", "signer":
+		return TypeTag{Kind: s}, nil
+	}
+	if strings.HasPrefix(s, "vector<") && strings.HasSuffix(s, ">") {
+		inner, err := ParseTypeTag(s[len("vector<") : len(s)-1])
+		if err != nil {
+			return TypeTag{}, err
+		}
+		return TypeTag{Kind: "vector", Vector: &inner}, nil
+	}
+	if strings.Contains(s, "<") {
+		return TypeTag{}, fmt.Errorf("types: generic struct type tag %q is not supported", s)
+	}
+	parts := strings.Split(s, "::")
+	if len(parts) != 3 {
+		return TypeTag{}, fmt.Errorf("types: invalid struct type tag %q", s)
+	}
+	return TypeTag{Kind: "struct", Struct: &StructTag{Address: parts[0], Module: parts[1], Name: parts[2]}}, nil
+}
+
+// String renders t back into the "0x2::sui::SUI" / "vector<u8>" form
+// ParseTypeTag accepts, so a MoveCall decoded off the wire still has a
+// human-readable TypeArgs entry even though the original type string
+// itself isn't part of the BCS encoding.
+func (t TypeTag) String() string {
+	switch t.Kind {
+	case "vector":
+		return "vector<" + t.Vector.String() + ">"
+	case "struct":
+		return t.Struct.Address + "::" + t.Struct.Module + "::" + t.Struct.Name
+	default:
+		return t.Kind
+	}
+}
+
+func (t TypeTag) marshalBCS(e *bcs.Encoder) error {
+	switch t.Kind {
+	case "bool":
+		e.WriteULEB(typeTagBool)
+	case "u8":
+		e.WriteULEB(typeTagU8)
+	case "u16":
+		e.WriteULEB(typeTagU16)
+	case "u32":
+		e.WriteULEB(typeTagU32)
+	case "u64":
+		e.WriteULEB(typeTagU64)
+	case "u128":
+		e.WriteULEB(typeTagU128)
+	case "u256":
+		e.WriteULEB(typeTagU256)
+	case "address":
+		e.WriteULEB(typeTagAddress)
+	case "signer":
+		e.WriteULEB(typeTagSigner)
+	case "vector":
+		e.WriteULEB(typeTagVector)
+		return t.Vector.marshalBCS(e)
+	case "struct":
+		e.WriteULEB(typeTagStruct)
+		addr, err := NewAddressFromHex(t.Struct.Address)
+		if err != nil {
+			return fmt.Errorf("types: struct type tag address: %w", err)
+		}
+		e.WriteFixedBytes(addr.Data())
+		e.WriteBytes([]byte(t.Struct.Module))
+		e.WriteBytes([]byte(t.Struct.Name))
+		e.WriteULEB(0) // no generic type arguments supported
+	default:
+		return fmt.Errorf("types: empty TypeTag")
+	}
+	return nil
+}
+
+func unmarshalTypeTag(d *bcs.Decoder) (TypeTag, error) {
+	variant, err := d.ReadULEB()
+	if err != nil {
+		return TypeTag{}, err
+	}
+	switch variant {
+	case typeTagBool:
+		return TypeTag{Kind: "bool"}, nil
+	case typeTagU8:
+		return TypeTag{Kind: "u8"}, nil
+	case typeTagU16:
+		return TypeTag{Kind: "u16"}, nil
+	case typeTagU32:
+		return TypeTag{Kind: "u32"}, nil
+	case typeTagU64:
+		return TypeTag{Kind: "u64"}, nil
+	case typeTagU128:
+		return TypeTag{Kind: "u128"}, nil
+	case typeTagU256:
+		return TypeTag{Kind: "u256"}, nil
+	case typeTagAddress:
+		return TypeTag{Kind: "address"}, nil
+	case typeTagSigner:
+		return TypeTag{Kind: "signer"}, nil
+	case typeTagVector:
+		inner, err := unmarshalTypeTag(d)
+		if err != nil {
+			return TypeTag{}, err
+		}
+		return TypeTag{Kind: "vector", Vector: &inner}, nil
+	case typeTagStruct:
+		idBytes, err := d.ReadFixedBytes(addressLength)
+		if err != nil {
+			return TypeTag{}, err
+		}
+		moduleBytes, err := d.ReadBytes()
+		if err != nil {
+			return TypeTag{}, err
+		}
+		nameBytes, err := d.ReadBytes()
+		if err != nil {
+			return TypeTag{}, err
+		}
+		n, err := d.ReadULEB()
+		if err != nil {
+			return TypeTag{}, err
+		}
+		if n != 0 {
+			return TypeTag{}, fmt.Errorf("types: generic struct type tags are not supported")
+		}
+		addr := HexData{data: idBytes}
+		return TypeTag{Kind: "struct", Struct: &StructTag{
+			Address: addr.ShortString(),
+			Module:  string(moduleBytes),
+			Name:    string(nameBytes),
+		}}, nil
+	default:
+		return TypeTag{}, fmt.Errorf("types: unknown TypeTag variant %d", variant)
+	}
+}
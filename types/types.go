@@ -12,6 +12,9 @@ import (
 
 type Address = HexData
 
+// addressLength is the byte width of a Sui address/object ID in this SDK.
+const addressLength = 20
+
 /**
  * Creates Address from a hex string.
  * @param addr Hex string can be with a prefix or without a prefix,
@@ -29,7 +32,6 @@ func NewAddressFromHex(addr string) (*Address, error) {
 	if err != nil {
 		return nil, err
 	}
-	const addressLength = 20
 	if len(bytes) > addressLength {
 		return nil, fmt.Errorf("Hex string is too long. Address's length is %v bytes.", addressLength)
 	}
@@ -111,6 +113,14 @@ type MoveCall struct {
 	Function string        `json:"function"`
 	TypeArgs []interface{} `json:"typeArguments"`
 	Args     []interface{} `json:"arguments"`
+
+	// BCSTypeArgs and BCSArgs hold the typed Move type tags and call
+	// arguments needed to BCS-encode this call exactly as a fullnode
+	// would; see marshalBCS. TransactionBuilder.AddMoveCall populates
+	// both them and the JSON-facing TypeArgs/Args above; unmarshalBCS
+	// populates them when decoding a call off the wire.
+	BCSTypeArgs []TypeTag `json:"-"`
+	BCSArgs     []BCSArg  `json:"-"`
 }
 type TransferSui struct {
 	Recipient Address `json:"recipient"`
@@ -122,12 +132,18 @@ type ChangeEpoch struct {
 	ComputationCharge uint64      `json:"computation_charge"`
 }
 
+type SplitCoin struct {
+	Coin         ObjectId `json:"coin"`
+	SplitAmounts []uint64 `json:"split_amounts"`
+}
+
 type SingleTransactionKind struct {
 	TransferObject *TransferObject `json:"TransferObject,omitempty"`
 	Publish        *ModulePublish  `json:"Publish,omitempty"`
 	Call           *MoveCall       `json:"Call,omitempty"`
 	TransferSui    *TransferSui    `json:"TransferSui,omitempty"`
 	ChangeEpoch    *ChangeEpoch    `json:"ChangeEpoch,omitempty"`
+	SplitCoin      *SplitCoin      `json:"SplitCoin,omitempty"`
 }
 
 type SenderSignedData struct {
@@ -232,19 +248,48 @@ type ObjectInfo struct {
 // This is currently hardcoded with [IntentScope::TransactionData = 0, Version::V0 = 0, AppId::Sui = 0]
 var INTENT_BYTES = []byte{0, 0, 0}
 
-func (txn *TransactionBytes) SignWith(privateKey ed25519.PrivateKey) *SignedTransaction {
+// Signer produces a signature over a message using a particular signature
+// scheme. Concrete implementations (Ed25519Signer, Secp256k1Signer) live
+// under account/, so transaction building and submission code never needs
+// to know which key scheme it's dealing with, mirroring how go-ethereum's
+// types.Signer decouples transaction signing from raw key material.
+type Signer interface {
+	Sign(msg []byte) (signature, pubKey []byte, scheme SignatureScheme)
+}
+
+// SignWithSigner signs the transaction with signer and returns the resulting
+// SignedTransaction, with SigScheme, Signature and PublicKey all derived from
+// the signer rather than assumed to be Ed25519.
+func (txn *TransactionBytes) SignWithSigner(signer Signer) *SignedTransaction {
 	signTx := bytes.NewBuffer(INTENT_BYTES)
 	signTx.Write(txn.TxBytes.Data())
 	message := signTx.Bytes()
-	signature := ed25519.Sign(privateKey, message)
+
+	signature, pubKey, scheme := signer.Sign(message)
 	sign := Bytes(signature).GetBase64Data()
-	publicKey := privateKey.Public().(ed25519.PublicKey)
-	pub := Bytes(publicKey).GetBase64Data()
+	pub := Bytes(pubKey).GetBase64Data()
 
 	return &SignedTransaction{
 		TxBytes:   &txn.TxBytes,
-		SigScheme: SignatureSchemeEd25519,
+		SigScheme: scheme,
 		Signature: &sign,
 		PublicKey: &pub,
 	}
 }
+
+// SignWith signs the transaction with a raw Ed25519 private key. It's kept
+// around for callers that haven't moved to account.Ed25519Signer /
+// account.Secp256k1Signer yet; it's equivalent to SignWithSigner with an
+// Ed25519-only signer.
+func (txn *TransactionBytes) SignWith(privateKey ed25519.PrivateKey) *SignedTransaction {
+	return txn.SignWithSigner(ed25519Signer{privateKey})
+}
+
+type ed25519Signer struct {
+	privateKey ed25519.PrivateKey
+}
+
+func (s ed25519Signer) Sign(msg []byte) (signature, pubKey []byte, scheme SignatureScheme) {
+	publicKey := s.privateKey.Public().(ed25519.PublicKey)
+	return ed25519.Sign(s.privateKey, msg), publicKey, SignatureSchemeEd25519
+}